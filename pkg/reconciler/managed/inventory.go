@@ -0,0 +1,259 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// DefaultInventoryRefreshInterval is how often the InventoryMetricsCollector
+// re-discovers the set of GVRs to watch when Start is called with a zero or
+// negative interval.
+const DefaultInventoryRefreshInterval = 5 * time.Minute
+
+// A ClusterMetric selects the CRDs whose instances should be counted by an
+// InventoryMetricsCollector. A CRD is selected when its name (e.g.
+// "rdsinstances.database.aws.crossplane.io") ends with NameSuffix.
+type ClusterMetric struct {
+	// NameSuffix is matched against the end of a CRD's name, for example
+	// ".crossplane.io" to match every managed resource and claim CRD
+	// installed by Crossplane and its providers.
+	NameSuffix string
+}
+
+// Matches returns true if the supplied CRD name is selected by this
+// ClusterMetric.
+func (m ClusterMetric) Matches(crdName string) bool {
+	return strings.HasSuffix(crdName, m.NameSuffix)
+}
+
+// InventoryMetricsCollector is a prometheus.Collector that counts managed
+// resources across the whole cluster. Unlike prometheusMetricsReconciler,
+// which only observes the resources passing through a single reconciler, the
+// InventoryMetricsCollector discovers every matching CRD via the discovery
+// client and counts all of their instances - including ones no controller is
+// currently watching.
+type InventoryMetricsCollector struct {
+	discovery discovery.DiscoveryInterface
+	dynamic   dynamic.Interface
+	selectors []ClusterMetric
+
+	mu   sync.RWMutex
+	gvrs []inventoryGVR
+
+	resourceCount *prometheus.Desc
+	totalCount    *prometheus.Desc
+	readyCount    *prometheus.Desc
+	syncedCount   *prometheus.Desc
+	deletingCount *prometheus.Desc
+}
+
+type inventoryGVR struct {
+	schema.GroupVersionResource
+	Kind string
+}
+
+// NewInventoryMetricsCollector returns a prometheus.Collector that counts
+// managed resources cluster-wide, grouped by GroupVersionResource. Call
+// Start to keep the set of watched GVRs in sync with CRDs being installed
+// and uninstalled, then register the collector with a
+// metrics.RegistererGatherer as you would NewPrometheusMetricsReconciler.
+func NewInventoryMetricsCollector(disc discovery.DiscoveryInterface, dyn dynamic.Interface, selectors ...ClusterMetric) *InventoryMetricsCollector {
+	return &InventoryMetricsCollector{
+		discovery: disc,
+		dynamic:   dyn,
+		selectors: selectors,
+		resourceCount: prometheus.NewDesc("crossplane_managed_resource_count",
+			"Number of managed resources in the cluster, by GroupVersionResource",
+			[]string{"group", "version", "kind"}, nil),
+		totalCount: prometheus.NewDesc("crossplane_managed_resource_total",
+			"Total number of managed resources in the cluster", nil, nil),
+		readyCount: prometheus.NewDesc("crossplane_managed_resource_ready_count",
+			"Number of managed resources in the cluster with condition Ready=True, by GroupVersionResource",
+			[]string{"group", "version", "kind"}, nil),
+		syncedCount: prometheus.NewDesc("crossplane_managed_resource_synced_count",
+			"Number of managed resources in the cluster with condition Synced=True, by GroupVersionResource",
+			[]string{"group", "version", "kind"}, nil),
+		deletingCount: prometheus.NewDesc("crossplane_managed_resource_deleting_count",
+			"Number of managed resources in the cluster with a deletion timestamp, by GroupVersionResource",
+			[]string{"group", "version", "kind"}, nil),
+	}
+}
+
+// RegisterWith registers the collector with the supplied RegistererGatherer,
+// mirroring NewPrometheusMetricsReconciler.
+func (c *InventoryMetricsCollector) RegisterWith(registry metrics.RegistererGatherer) {
+	registry.MustRegister(c)
+}
+
+// Start discovers the GVRs to watch and refreshes them on the supplied
+// interval, so that CRDs installed (or removed) after startup are picked up
+// without requiring a restart. It blocks until ctx is done.
+func (c *InventoryMetricsCollector) Start(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultInventoryRefreshInterval
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		log.Log.Error(err, "cannot perform initial discovery of managed resource GVRs")
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := c.refresh(ctx); err != nil {
+				log.Log.Error(err, "cannot refresh managed resource GVRs")
+			}
+		}
+	}
+}
+
+// refresh asks the discovery client which GVRs are currently served by the
+// API server and updates the set this collector counts instances of. A CRD
+// is exposed as a resource named "<plural>.<group>" - the same convention
+// its own name follows - so we reconstruct that name from each discovered
+// APIResource to run it through our selectors without ever reading the
+// CustomResourceDefinition objects themselves.
+func (c *InventoryMetricsCollector) refresh(_ context.Context) error {
+	// ServerPreferredResources returns a non-nil error alongside partial
+	// results when only some API groups fail to respond (e.g. an
+	// aggregated API server that's temporarily down); count what we can
+	// discover rather than giving up entirely.
+	lists, err := c.discovery.ServerPreferredResources()
+	if err != nil && len(lists) == 0 {
+		return err
+	}
+
+	gvrs := make([]inventoryGVR, 0)
+	for _, l := range lists {
+		gv, perr := schema.ParseGroupVersion(l.GroupVersion)
+		if perr != nil {
+			continue
+		}
+
+		for _, r := range l.APIResources {
+			if strings.Contains(r.Name, "/") {
+				// Subresource, e.g. "rdsinstances/status".
+				continue
+			}
+
+			name := r.Name + "." + gv.Group
+
+			matched := false
+			for _, s := range c.selectors {
+				if s.Matches(name) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+
+			gvrs = append(gvrs, inventoryGVR{
+				GroupVersionResource: schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: r.Name},
+				Kind:                 r.Kind,
+			})
+		}
+	}
+
+	c.mu.Lock()
+	c.gvrs = gvrs
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (c *InventoryMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.resourceCount
+	ch <- c.totalCount
+	ch <- c.readyCount
+	ch <- c.syncedCount
+	ch <- c.deletingCount
+}
+
+// Collect implements prometheus.Collector. It lists every instance of every
+// discovered GVR, so it may be expensive on large clusters; Prometheus calls
+// Collect synchronously per scrape.
+func (c *InventoryMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	gvrs := c.gvrs
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var total float64
+
+	for _, gvr := range gvrs {
+		list, err := c.dynamic.Resource(gvr.GroupVersionResource).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Log.Error(err, "cannot list managed resources", "gvr", gvr.GroupVersionResource.String())
+			continue
+		}
+
+		var ready, synced, deleting float64
+		for _, u := range list.Items {
+			if u.GetDeletionTimestamp() != nil {
+				deleting++
+			}
+			conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+			for _, cond := range conditions {
+				cm, ok := cond.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				ctype, _, _ := unstructured.NestedString(cm, "type")
+				cstatus, _, _ := unstructured.NestedString(cm, "status")
+				switch {
+				case ctype == "Ready" && cstatus == "True":
+					ready++
+				case ctype == "Synced" && cstatus == "True":
+					synced++
+				}
+			}
+		}
+
+		count := float64(len(list.Items))
+		total += count
+
+		ch <- prometheus.MustNewConstMetric(c.resourceCount, prometheus.GaugeValue, count, gvr.Group, gvr.Version, gvr.Kind)
+		ch <- prometheus.MustNewConstMetric(c.readyCount, prometheus.GaugeValue, ready, gvr.Group, gvr.Version, gvr.Kind)
+		ch <- prometheus.MustNewConstMetric(c.syncedCount, prometheus.GaugeValue, synced, gvr.Group, gvr.Version, gvr.Kind)
+		ch <- prometheus.MustNewConstMetric(c.deletingCount, prometheus.GaugeValue, deleting, gvr.Group, gvr.Version, gvr.Kind)
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.totalCount, prometheus.GaugeValue, total)
+}
@@ -17,7 +17,15 @@ limitations under the License.
 package managed
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -27,32 +35,110 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// maxManagementPolicyLabelActions bounds the cardinality of the
+// management_policy label. Resources with more management policy actions
+// than this are labelled with a hash of their (sorted) policy set instead of
+// the set itself.
+const maxManagementPolicyLabelActions = 3
+
+// managedStatusLabels are the labels shared by the managed resource status
+// gauges. The controller_version label is added dynamically, only for
+// reconcilers constructed with NewPrometheusMetricsReconcilerWithBuildInfo
+// and without WithoutControllerVersionLabel, so that reconcilers which don't
+// want that dimension never carry it - not even as an empty value.
+var managedStatusLabels = []string{"group", "kind", "name", "claim_name", "claim_namespace", "management_policy"}
+
 var (
-	managedStatusSynced = prometheus.NewGaugeVec(
+	runtimeBuildInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
-			Name: "managed_resource_status_synced",
-			Help: "Managed resources is synced",
+			Name: "crossplane_runtime_build_info",
+			Help: "Info metric, always 1, with the version, revision and provider of the running controller as labels",
 		},
-		[]string{"group", "kind", "name", "claim_name", "claim_namespace"},
+		[]string{"version", "revision", "provider"},
 	)
-	managedStatusReady = prometheus.NewGaugeVec(
+	managedManagementPolicy = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
-			Name: "managed_resource_status_ready",
-			Help: "Managed resources is ready",
+			Name: "managed_resource_management_policy",
+			Help: "Info metric, always 1, with the active management policy of a managed resource as a label",
+		},
+		[]string{"group", "kind", "name", "claim_name", "claim_namespace", "management_policy"},
+	)
+	managedLastObservationTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "managed_resource_last_observation_timestamp_seconds",
+			Help: "Unix timestamp of the last time this managed resource was observed",
 		},
 		[]string{"group", "kind", "name", "claim_name", "claim_namespace"},
 	)
-	managedStatusDeleting = prometheus.NewGaugeVec(
+	managedDeletionTimestamp = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
-			Name: "managed_resource_status_deleting",
-			Help: "Managed resources is being deleted",
+			Name: "managed_resource_deletion_timestamp_seconds",
+			Help: "Unix timestamp at which this managed resource's deletion timestamp was set, or 0 if it is not being deleted",
 		},
 		[]string{"group", "kind", "name", "claim_name", "claim_namespace"},
 	)
+
+	managedReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "managed_resource_reconcile_duration_seconds",
+			Help:    "Time spent in a single stage of a managed resource's reconcile loop",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"group", "kind", "stage"},
+	)
+	managedReconcileErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "managed_resource_reconcile_errors_total",
+			Help: "Number of reconcile errors, by stage",
+		},
+		[]string{"group", "kind", "stage"},
+	)
+	managedTimeToReady = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "managed_resource_time_to_ready_seconds",
+			Help:    "Time elapsed between a managed resource's first observation and it becoming Ready",
+			Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"group", "kind"},
+	)
+	managedTimeToDelete = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "managed_resource_time_to_delete_seconds",
+			Help:    "Time elapsed between a managed resource's deletion timestamp being set and it being removed",
+			Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"group", "kind"},
+	)
+)
+
+// A ReconcileStage identifies the stage of a managed resource's reconcile
+// loop that a ReconcileStart or ReconcileEnd call pertains to.
+type ReconcileStage string
+
+// Stages of the managed reconciler's reconcile loop.
+const (
+	StageObserve ReconcileStage = "observe"
+	StageCreate  ReconcileStage = "create"
+	StageUpdate  ReconcileStage = "update"
+	StageDelete  ReconcileStage = "delete"
 )
 
+// MetricsReconciler is called by the managed reconciler to record metrics
+// about the managed resources it reconciles.
 type MetricsReconciler interface {
 	ReconcileMetrics(req reconcile.Request, managed resource.Managed)
+
+	// ReconcileStart is called immediately before the named stage of a
+	// reconcile runs. Every call must be paired with a matching ReconcileEnd
+	// call for the same req and stage - implementations may use this pairing
+	// to measure how long the stage took.
+	ReconcileStart(req reconcile.Request, managed resource.Managed, stage ReconcileStage)
+
+	// ReconcileEnd is called immediately after the named stage of a
+	// reconcile returns, with any error it returned. It must be called
+	// exactly once for each preceding ReconcileStart call with the same req
+	// and stage.
+	ReconcileEnd(req reconcile.Request, managed resource.Managed, err error, stage ReconcileStage)
 }
 
 // NewNopMetricsReconciler returns a no-op metrics collector
@@ -64,22 +150,212 @@ type nopMetricsReconciler struct{}
 
 func (n nopMetricsReconciler) ReconcileMetrics(req reconcile.Request, managed resource.Managed) {}
 
+func (n nopMetricsReconciler) ReconcileStart(req reconcile.Request, managed resource.Managed, stage ReconcileStage) {
+}
+
+func (n nopMetricsReconciler) ReconcileEnd(req reconcile.Request, managed resource.Managed, err error, stage ReconcileStage) {
+}
+
+// A PrometheusReconcilerOption configures a prometheusMetricsReconciler
+// returned by NewPrometheusMetricsReconciler.
+type PrometheusReconcilerOption func(*prometheusMetricsReconciler)
+
+// WithOwnedFinalizers tells the reconciler which finalizers it is
+// responsible for. Metric label series for a deleted managed resource are
+// only removed once none of these finalizers remain on the object, rather
+// than as soon as its finalizer list is empty - avoiding a race with other
+// controllers that add their own finalizers to the same object. If no
+// owned finalizers are supplied the reconciler falls back to its previous
+// behaviour of waiting for the finalizer list to be empty.
+func WithOwnedFinalizers(finalizers ...string) PrometheusReconcilerOption {
+	return func(p *prometheusMetricsReconciler) {
+		p.ownedFinalizers = finalizers
+	}
+}
+
+// WithLegacyDeletingGauge continues to populate the deprecated
+// managed_resource_status_deleting gauge alongside
+// managed_resource_deletion_timestamp_seconds, for consumers who haven't
+// yet migrated their dashboards and alerts.
+func WithLegacyDeletingGauge() PrometheusReconcilerOption {
+	return func(p *prometheusMetricsReconciler) {
+		p.legacyDeletingGauge = true
+	}
+}
+
+// WithoutControllerVersionLabel drops the controller_version label
+// dimension from the managed resource status gauges entirely, rather than
+// populating it, when used with
+// NewPrometheusMetricsReconcilerWithBuildInfo. crossplane_runtime_build_info
+// is still exported, so operators who find the extra per-series cost isn't
+// worth it can still correlate a rollout with resource counts by joining on
+// build info's timestamp instead.
+func WithoutControllerVersionLabel() PrometheusReconcilerOption {
+	return func(p *prometheusMetricsReconciler) {
+		p.omitControllerVersionLabel = true
+	}
+}
+
+// BuildInfo identifies the version of the controller emitting managed
+// resource metrics.
+type BuildInfo struct {
+	// Version of the controller, e.g. a semver tag.
+	Version string
+
+	// Revision is the VCS revision the controller was built from, e.g. a
+	// git commit SHA.
+	Revision string
+
+	// ProviderName of the controller, e.g. "provider-aws".
+	ProviderName string
+}
+
+// NewPrometheusMetricsReconcilerWithBuildInfo returns a prometheus metrics
+// reconciler that, in addition to everything NewPrometheusMetricsReconciler
+// records, exports a crossplane_runtime_build_info gauge and adds a
+// controller_version label, set to info.Version, to the managed resource
+// status gauges. This makes it possible to tell whether stuck or
+// not-ready managed resources correlate with a specific controller version
+// during a rollout.
+//
+// Adding controller_version to every managed resource series multiplies
+// their cardinality by the number of controller versions that have ever
+// reconciled an object; use WithoutControllerVersionLabel to keep the
+// build info gauge without adding that label dimension to the status
+// gauges.
+func NewPrometheusMetricsReconcilerWithBuildInfo(registry metrics.RegistererGatherer, info BuildInfo, o ...PrometheusReconcilerOption) *prometheusMetricsReconciler {
+	p := newPrometheusMetricsReconciler(registry, true, o...)
+
+	if !p.omitControllerVersionLabel {
+		p.controllerVersion = info.Version
+	}
+
+	registry.MustRegister(runtimeBuildInfo)
+	runtimeBuildInfo.WithLabelValues(info.Version, info.Revision, info.ProviderName).Set(1)
+
+	return p
+}
+
 // NewPrometheusMetricsReconciler returns a prometheus metrics
 // reconciler. Only one instance should be created as the collectors
 // should only be registered once.
-func NewPrometheusMetricsReconciler(registry metrics.RegistererGatherer) prometheusMetricsReconciler {
-	registry.MustRegister(managedStatusDeleting, managedStatusReady, managedStatusSynced)
-	return prometheusMetricsReconciler{}
+func NewPrometheusMetricsReconciler(registry metrics.RegistererGatherer, o ...PrometheusReconcilerOption) *prometheusMetricsReconciler {
+	return newPrometheusMetricsReconciler(registry, false, o...)
+}
+
+// newPrometheusMetricsReconciler builds the status gauges with or without
+// the controller_version label dimension depending on withControllerVersion
+// and the WithoutControllerVersionLabel option, so reconcilers that don't
+// use build info - or that explicitly opt out - never carry that dimension.
+func newPrometheusMetricsReconciler(registry metrics.RegistererGatherer, withControllerVersion bool, o ...PrometheusReconcilerOption) *prometheusMetricsReconciler {
+	p := &prometheusMetricsReconciler{
+		stageStarts:    make(map[stageKey]time.Time),
+		firstObserved:  make(map[types.NamespacedName]time.Time),
+		readyRecorded:  make(map[types.NamespacedName]bool),
+		deleteRecorded: make(map[types.NamespacedName]bool),
+		lastPolicy:     make(map[types.NamespacedName]string),
+	}
+	for _, fn := range o {
+		fn(p)
+	}
+
+	labels := managedStatusLabels
+	if withControllerVersion && !p.omitControllerVersionLabel {
+		p.includeControllerVersionLabel = true
+		labels = append(append([]string{}, managedStatusLabels...), "controller_version")
+	}
+
+	p.statusReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "managed_resource_status_ready",
+		Help: "Managed resources is ready",
+	}, labels)
+	p.statusSynced = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "managed_resource_status_synced",
+		Help: "Managed resources is synced",
+	}, labels)
+
+	collectors := []prometheus.Collector{
+		p.statusReady, p.statusSynced, managedDeletionTimestamp,
+		managedManagementPolicy, managedLastObservationTime,
+		managedReconcileDuration, managedReconcileErrors, managedTimeToReady, managedTimeToDelete,
+	}
+	if p.legacyDeletingGauge {
+		p.statusDeleting = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "managed_resource_status_deleting",
+			Help: "Managed resources is being deleted",
+		}, labels)
+		collectors = append(collectors, p.statusDeleting)
+	}
+	registry.MustRegister(collectors...)
+
+	return p
+}
+
+type stageKey struct {
+	req   reconcile.Request
+	stage ReconcileStage
 }
 
-type prometheusMetricsReconciler struct{}
+type prometheusMetricsReconciler struct {
+	mu sync.Mutex
+
+	// stageStarts tracks the time ReconcileStart was called for a given
+	// request and stage, so ReconcileEnd can compute how long the stage
+	// took.
+	stageStarts map[stageKey]time.Time
+
+	// firstObserved tracks the first time a managed resource was
+	// reconciled, so we can measure how long it took to become ready.
+	// readyRecorded and deleteRecorded guard the corresponding histogram
+	// observations so each only fires once per object.
+	firstObserved  map[types.NamespacedName]time.Time
+	readyRecorded  map[types.NamespacedName]bool
+	deleteRecorded map[types.NamespacedName]bool
+
+	// lastPolicy is the management_policy label value last used for a given
+	// managed resource's series, so that a change to its management
+	// policies can evict the old series instead of leaking it alongside the
+	// new one.
+	lastPolicy map[types.NamespacedName]string
+
+	// ownedFinalizers are the finalizers this reconciler is responsible
+	// for. See WithOwnedFinalizers.
+	ownedFinalizers []string
+
+	// legacyDeletingGauge enables writes to the deprecated
+	// managed_resource_status_deleting gauge. See WithLegacyDeletingGauge.
+	legacyDeletingGauge bool
+
+	// statusReady, statusSynced and (if legacyDeletingGauge) statusDeleting
+	// are built in newPrometheusMetricsReconciler, since whether they carry
+	// a controller_version label depends on how this reconciler was
+	// constructed.
+	statusReady    *prometheus.GaugeVec
+	statusSynced   *prometheus.GaugeVec
+	statusDeleting *prometheus.GaugeVec
+
+	// includeControllerVersionLabel is true when statusReady, statusSynced
+	// and statusDeleting were built with a controller_version label, in
+	// which case controllerVersion must be appended to their label values.
+	includeControllerVersionLabel bool
+
+	// controllerVersion is stamped onto the controller_version label of
+	// managed resource metrics when set via
+	// NewPrometheusMetricsReconcilerWithBuildInfo.
+	controllerVersion string
+
+	// omitControllerVersionLabel disables the controller_version label
+	// even when a controllerVersion has been configured. See
+	// WithoutControllerVersionLabel.
+	omitControllerVersionLabel bool
+}
 
 const (
 	LabelKeyClaimName      = "crossplane.io/claim-name"
 	LabelKeyClaimNamespace = "crossplane.io/claim-namespace"
 )
 
-func (p prometheusMetricsReconciler) ReconcileMetrics(req reconcile.Request, managed resource.Managed) {
+func (p *prometheusMetricsReconciler) ReconcileMetrics(req reconcile.Request, managed resource.Managed) {
 	group := managed.GetObjectKind().GroupVersionKind().Group
 	kind := managed.GetObjectKind().GroupVersionKind().Kind
 	name := managed.GetName()
@@ -88,6 +364,10 @@ func (p prometheusMetricsReconciler) ReconcileMetrics(req reconcile.Request, man
 
 	ready := managed.GetCondition(xpv1.TypeReady).Status
 	synced := managed.GetCondition(xpv1.TypeSynced).Status
+	policy := managementPolicyLabel(managed)
+
+	p.trackTimeToReady(req.NamespacedName, group, kind, ready)
+	p.trackTimeToDelete(managed, group, kind)
 
 	deleting := corev1.ConditionFalse
 	if meta.WasDeleted(managed) {
@@ -99,28 +379,68 @@ func (p prometheusMetricsReconciler) ReconcileMetrics(req reconcile.Request, man
 		value corev1.ConditionStatus
 	}{
 		{
-			gauge: managedStatusReady,
+			gauge: p.statusReady,
 			value: ready,
 		},
 		{
-			gauge: managedStatusSynced,
+			gauge: p.statusSynced,
 			value: synced,
 		},
-		{
-			gauge: managedStatusDeleting,
-			value: deleting,
-		},
+	}
+	if p.legacyDeletingGauge {
+		gauges = append(gauges, struct {
+			gauge *prometheus.GaugeVec
+			value corev1.ConditionStatus
+		}{gauge: p.statusDeleting, value: deleting})
 	}
 
-	labelValues := []string{group, kind, name, claimName, claimNamespace}
+	labelValues := []string{group, kind, name, claimName, claimNamespace, policy}
+	if p.includeControllerVersionLabel {
+		labelValues = append(labelValues, p.controllerVersion)
+	}
+	policyLabelValues := []string{group, kind, name, claimName, claimNamespace, policy}
+	infoLabelValues := []string{group, kind, name, claimName, claimNamespace}
+
+	nn := types.NamespacedName{Namespace: managed.GetNamespace(), Name: managed.GetName()}
 
-	// attempt to clean up metrics for objects that will cease to
-	// exist when the API server will run garbage collection
-	if meta.WasDeleted(managed) && len(managed.GetFinalizers()) == 0 {
+	// Only remove this object's label series once none of the finalizers
+	// we own are still present - a foreign finalizer left behind by
+	// another controller shouldn't keep us from cleaning up, but racing
+	// ahead of our own finalizer would delete series for an object the
+	// API server hasn't garbage collected yet.
+	if meta.WasDeleted(managed) && !ownedFinalizersRemain(managed, p.ownedFinalizers) {
 		for _, g := range gauges {
 			g.gauge.DeleteLabelValues(labelValues...)
 		}
+		managedManagementPolicy.DeleteLabelValues(policyLabelValues...)
+		managedLastObservationTime.DeleteLabelValues(infoLabelValues...)
+		managedDeletionTimestamp.DeleteLabelValues(infoLabelValues...)
+
+		p.mu.Lock()
+		delete(p.lastPolicy, nn)
+		p.mu.Unlock()
 	} else {
+		// The management_policy label is mutable: a resource's
+		// managementPolicies can change over its lifetime. If it has, the
+		// series we're about to write under the new policy value would
+		// otherwise sit alongside a stale one under the old value that
+		// nothing ever updates again - evict it first.
+		p.mu.Lock()
+		old, seen := p.lastPolicy[nn]
+		p.lastPolicy[nn] = policy
+		p.mu.Unlock()
+
+		if seen && old != policy {
+			oldLabelValues := []string{group, kind, name, claimName, claimNamespace, old}
+			if p.includeControllerVersionLabel {
+				oldLabelValues = append(oldLabelValues, p.controllerVersion)
+			}
+			for _, g := range gauges {
+				g.gauge.DeleteLabelValues(oldLabelValues...)
+			}
+			managedManagementPolicy.DeleteLabelValues(group, kind, name, claimName, claimNamespace, old)
+		}
+
 		for _, g := range gauges {
 			val := 0.0
 			if g.value == corev1.ConditionTrue {
@@ -129,5 +449,150 @@ func (p prometheusMetricsReconciler) ReconcileMetrics(req reconcile.Request, man
 
 			g.gauge.WithLabelValues(labelValues...).Set(val)
 		}
+
+		managedManagementPolicy.WithLabelValues(policyLabelValues...).Set(1)
+		managedLastObservationTime.WithLabelValues(infoLabelValues...).Set(float64(time.Now().Unix()))
+
+		deletionTimestamp := 0.0
+		if dt := managed.GetDeletionTimestamp(); dt != nil {
+			deletionTimestamp = float64(dt.Unix())
+		}
+		managedDeletionTimestamp.WithLabelValues(infoLabelValues...).Set(deletionTimestamp)
+	}
+}
+
+// ownedFinalizersRemain returns true if any of the owned finalizers are
+// still present on managed. If owned is empty it falls back to reporting
+// whether managed has any finalizers at all, preserving this reconciler's
+// original (pre-ownership-aware) cleanup behaviour.
+func ownedFinalizersRemain(managed resource.Managed, owned []string) bool {
+	if len(owned) == 0 {
+		return len(managed.GetFinalizers()) != 0
+	}
+
+	present := make(map[string]bool, len(managed.GetFinalizers()))
+	for _, f := range managed.GetFinalizers() {
+		present[f] = true
+	}
+	for _, f := range owned {
+		if present[f] {
+			return true
+		}
+	}
+	return false
+}
+
+// managementPolicyLabel renders a managed resource's management policy set
+// as a single, low-cardinality label value. Small policy sets are rendered
+// verbatim (sorted, joined by "+"); larger ones are collapsed to a short
+// hash so that the number of distinct management policy combinations in use
+// can't blow up label cardinality.
+func managementPolicyLabel(managed resource.Managed) string {
+	policies := managed.GetManagementPolicies()
+	if len(policies) == 0 {
+		return ""
+	}
+
+	sorted := make([]string, 0, len(policies))
+	for _, p := range policies {
+		sorted = append(sorted, string(p))
+	}
+	sort.Strings(sorted)
+
+	if len(sorted) <= maxManagementPolicyLabelActions {
+		return strings.Join(sorted, "+")
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(sorted, "+")))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// trackTimeToReady records how long it took the managed resource to go from
+// its first observed reconcile to Ready=True, the first time that happens.
+func (p *prometheusMetricsReconciler) trackTimeToReady(nn types.NamespacedName, group, kind string, ready corev1.ConditionStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.firstObserved[nn]; !ok {
+		p.firstObserved[nn] = time.Now()
+	}
+
+	if ready != corev1.ConditionTrue || p.readyRecorded[nn] {
+		return
+	}
+
+	managedTimeToReady.WithLabelValues(group, kind).Observe(time.Since(p.firstObserved[nn]).Seconds())
+	p.readyRecorded[nn] = true
+}
+
+// trackTimeToDelete records how long it took a managed resource to be
+// removed once its deletion timestamp was set, the moment this reconciler
+// considers it safe to stop tracking the object's metrics. It only observes
+// once per object - otherwise every reconcile between our finalizer being
+// removed and the API server actually garbage collecting the object would
+// record another, increasingly stale, observation.
+func (p *prometheusMetricsReconciler) trackTimeToDelete(managed resource.Managed, group, kind string) {
+	if !meta.WasDeleted(managed) || ownedFinalizersRemain(managed, p.ownedFinalizers) {
+		return
+	}
+
+	nn := types.NamespacedName{Namespace: managed.GetNamespace(), Name: managed.GetName()}
+
+	p.mu.Lock()
+	if p.deleteRecorded[nn] {
+		p.mu.Unlock()
+		return
+	}
+	p.deleteRecorded[nn] = true
+	delete(p.firstObserved, nn)
+	delete(p.readyRecorded, nn)
+	p.mu.Unlock()
+
+	managedTimeToDelete.WithLabelValues(group, kind).Observe(time.Since(managed.GetDeletionTimestamp().Time).Seconds())
+}
+
+// maxStageAge bounds how long a ReconcileStart entry can sit in stageStarts
+// without a matching ReconcileEnd before ReconcileStart evicts it. This
+// guards against unbounded growth if a stage panics, or otherwise returns
+// through a path that skips ReconcileEnd, instead of relying solely on
+// callers honouring the start/end contract.
+const maxStageAge = time.Hour
+
+// ReconcileStart records the time at which the named stage of a managed
+// resource's reconcile began, so ReconcileEnd can record how long it took.
+func (p *prometheusMetricsReconciler) ReconcileStart(req reconcile.Request, managed resource.Managed, stage ReconcileStage) {
+	now := time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for k, t := range p.stageStarts {
+		if now.Sub(t) > maxStageAge {
+			delete(p.stageStarts, k)
+		}
+	}
+
+	p.stageStarts[stageKey{req: req, stage: stage}] = now
+}
+
+// ReconcileEnd records how long the named stage of a managed resource's
+// reconcile took, and whether it returned an error.
+func (p *prometheusMetricsReconciler) ReconcileEnd(req reconcile.Request, managed resource.Managed, err error, stage ReconcileStage) {
+	group := managed.GetObjectKind().GroupVersionKind().Group
+	kind := managed.GetObjectKind().GroupVersionKind().Kind
+
+	key := stageKey{req: req, stage: stage}
+
+	p.mu.Lock()
+	start, ok := p.stageStarts[key]
+	delete(p.stageStarts, key)
+	p.mu.Unlock()
+
+	if ok {
+		managedReconcileDuration.WithLabelValues(group, kind, string(stage)).Observe(time.Since(start).Seconds())
+	}
+
+	if err != nil {
+		managedReconcileErrors.WithLabelValues(group, kind, string(stage)).Inc()
 	}
 }
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// ExternalClient manages the lifecycle of an external resource that
+// corresponds to a managed resource. Providers implement this to talk to
+// whatever API actually backs a particular kind of managed resource.
+type ExternalClient interface {
+	// Observe the external resource the supplied managed resource
+	// represents, if any.
+	Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error)
+
+	// Create an external resource per the specification of the supplied
+	// managed resource.
+	Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error)
+
+	// Update the external resource represented by the supplied managed
+	// resource, if necessary, to match its desired state.
+	Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error)
+
+	// Delete the external resource represented by the supplied managed
+	// resource.
+	Delete(ctx context.Context, mg resource.Managed) error
+}
+
+// ExternalObservation is the result of calling Observe.
+type ExternalObservation struct {
+	// ResourceExists must be true if the external resource referenced by
+	// the managed resource actually exists.
+	ResourceExists bool
+
+	// ResourceUpToDate must be true if the external resource is in the
+	// desired state.
+	ResourceUpToDate bool
+}
+
+// ExternalCreation is the result of calling Create.
+type ExternalCreation struct{}
+
+// ExternalUpdate is the result of calling Update.
+type ExternalUpdate struct{}
+
+// A Reconciler reconciles managed resources by creating and managing the
+// lifecycle of a corresponding external resource.
+type Reconciler struct {
+	client   client.Client
+	external ExternalClient
+	metrics  MetricsReconciler
+}
+
+// NewReconciler returns a Reconciler that reconciles managed resources by
+// calling the supplied ExternalClient, recording metrics via the supplied
+// MetricsReconciler as it goes.
+func NewReconciler(c client.Client, e ExternalClient, m MetricsReconciler) *Reconciler {
+	return &Reconciler{client: c, external: e, metrics: m}
+}
+
+// Reconcile the managed resource named by req with its external resource.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request, mg resource.Managed) (reconcile.Result, error) {
+	if err := r.client.Get(ctx, req.NamespacedName, mg); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	defer r.metrics.ReconcileMetrics(req, mg)
+
+	r.metrics.ReconcileStart(req, mg, StageObserve)
+	obs, err := r.external.Observe(ctx, mg)
+	r.metrics.ReconcileEnd(req, mg, err, StageObserve)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if meta.WasDeleted(mg) {
+		r.metrics.ReconcileStart(req, mg, StageDelete)
+		err := r.external.Delete(ctx, mg)
+		r.metrics.ReconcileEnd(req, mg, err, StageDelete)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, r.client.Status().Update(ctx, mg)
+	}
+
+	if !obs.ResourceExists {
+		r.metrics.ReconcileStart(req, mg, StageCreate)
+		_, err := r.external.Create(ctx, mg)
+		r.metrics.ReconcileEnd(req, mg, err, StageCreate)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{Requeue: true}, r.client.Status().Update(ctx, mg)
+	}
+
+	if !obs.ResourceUpToDate {
+		r.metrics.ReconcileStart(req, mg, StageUpdate)
+		_, err := r.external.Update(ctx, mg)
+		r.metrics.ReconcileEnd(req, mg, err, StageUpdate)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, r.client.Status().Update(ctx, mg)
+}